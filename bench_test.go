@@ -45,7 +45,7 @@ func BenchmarkHeapPriorityQueue(b *testing.B) {
 	})
 
 	b.Run("upsert", func(b *testing.B) {
-		for range b.N {
+		for i := 0; i < b.N; i++ {
 			pq.Upsert(rand.Int(), rand.Int())
 		}
 	})
@@ -0,0 +1,65 @@
+package priorityqueue
+
+import (
+	"bytes"
+	"container/heap"
+	"encoding/gob"
+	"sync/atomic"
+	"unsafe"
+
+	"golang.org/x/exp/constraints"
+)
+
+// serializedItem 是Item的可被gob编码的等价表示,避免直接暴露内部指针
+type serializedItem[T comparable, P constraints.Ordered] struct {
+	Value    T
+	Priority P
+}
+
+// serializedQueue 是HeapPriorityQueue序列化时的载荷:堆类型以及全部元素
+type serializedQueue[T comparable, P constraints.Ordered] struct {
+	HeapType HeapType
+	Items    []serializedItem[T, P]
+}
+
+// MarshalBinary 将队列编码为二进制数据,便于落盘快照。实现了encoding.BinaryMarshaler,
+// 因此encoding/gob在编码HeapPriorityQueue时会自动复用该方法
+func (pq *HeapPriorityQueue[T, P]) MarshalBinary() ([]byte, error) {
+	items := (*[]*Item[T, P])(atomic.LoadPointer(&pq.items))
+	payload := serializedQueue[T, P]{
+		HeapType: pq.heapType,
+		Items:    make([]serializedItem[T, P], len(*items)),
+	}
+	for i, item := range *items {
+		payload.Items[i] = serializedItem[T, P]{Value: item.Value, Priority: item.Priority}
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(payload); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary 从MarshalBinary产生的二进制数据中恢复队列,恢复后只调用一次heap.Init,
+// 因此成本是O(n)而非逐个Put的O(n log n)。实现了encoding.BinaryUnmarshaler,
+// 因此encoding/gob在解码HeapPriorityQueue时会自动复用该方法
+func (pq *HeapPriorityQueue[T, P]) UnmarshalBinary(data []byte) error {
+	var payload serializedQueue[T, P]
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&payload); err != nil {
+		return err
+	}
+
+	items := make([]*Item[T, P], len(payload.Items))
+	lookup := make(map[T]int, len(payload.Items))
+	for i, si := range payload.Items {
+		items[i] = &Item[T, P]{Value: si.Value, Priority: si.Priority}
+		lookup[si.Value] = i
+	}
+
+	pq.heapType = payload.HeapType
+	atomic.StorePointer(&pq.items, unsafe.Pointer(&items))
+	atomic.StorePointer(&pq.lookup, unsafe.Pointer(&lookup))
+	heap.Init(pq)
+	return nil
+}
@@ -0,0 +1,49 @@
+package priorityqueue
+
+import "testing"
+
+func TestBinaryMarshalRoundTrip(t *testing.T) {
+	pq := New[string, int](MaxHeap).(*HeapPriorityQueue[string, int])
+	pq.Put("a", 5)
+	pq.Put("b", 20)
+	pq.Put("c", 1)
+
+	data, err := pq.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	restored := New[string, int](MinHeap).(*HeapPriorityQueue[string, int])
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+
+	if restored.Len() != pq.Len() {
+		t.Fatalf("restored Len() = %d, want %d", restored.Len(), pq.Len())
+	}
+	// UnmarshalBinary restores the original HeapType, so draining should
+	// reproduce the same order as the source queue (MaxHeap: highest first).
+	want := []string{"b", "a", "c"}
+	for _, v := range want {
+		item := restored.GetAndPop()
+		if item == nil || item.Value != v {
+			t.Fatalf("restored drain = %v, want %s", item, v)
+		}
+	}
+}
+
+func TestBinaryMarshalEmptyQueue(t *testing.T) {
+	pq := New[string, int](MinHeap).(*HeapPriorityQueue[string, int])
+	data, err := pq.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	restored := New[string, int](MinHeap).(*HeapPriorityQueue[string, int])
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	if !restored.IsEmpty() {
+		t.Fatalf("expected restored queue to be empty")
+	}
+}
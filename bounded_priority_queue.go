@@ -0,0 +1,159 @@
+package priorityqueue
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// BoundedPriorityQueue 是容量固定的优先级队列,只保留按优先级排名前capacity的元素,
+// 常用于流式场景下的top-K统计(如只保留分数最高的100条记录)
+type BoundedPriorityQueue[T any, P constraints.Ordered] interface {
+	PriorityQueue[T, P]
+	// Drain 按排序顺序(最优先的在前)清空并返回队列中的全部元素
+	Drain() []*Item[T, P]
+}
+
+// boundedPriorityQueue 用min-max heap存储元素,使得保留集合中最好(Get/GetAndPop的对象)
+// 和最差(淘汰对象)的元素都能以O(1)查看、O(log n)弹出,无需为两个方向各维护一个堆
+type boundedPriorityQueue[T comparable, P constraints.Ordered] struct {
+	heap     DoubleEndedPriorityQueue[T, P]
+	kind     HeapType
+	capacity int
+}
+
+// NewBounded 创建一个容量为capacity的有界优先级队列,kind决定保留哪一端的元素
+// (MaxHeap保留优先级最高的capacity个,MinHeap保留优先级最低的capacity个)
+func NewBounded[T comparable, P constraints.Ordered](kind HeapType, capacity int) BoundedPriorityQueue[T, P] {
+	return &boundedPriorityQueue[T, P]{
+		heap:     NewMinMax[T, P](),
+		kind:     kind,
+		capacity: capacity,
+	}
+}
+
+// best/worst 返回当前保留集合中最值得保留、最该被淘汰的元素,取决于kind
+
+func (bq *boundedPriorityQueue[T, P]) best() *Item[T, P] {
+	if bq.kind == MaxHeap {
+		return bq.heap.PeekMax()
+	}
+	return bq.heap.PeekMin()
+}
+
+func (bq *boundedPriorityQueue[T, P]) popWorst() *Item[T, P] {
+	if bq.kind == MaxHeap {
+		return bq.heap.PopMin()
+	}
+	return bq.heap.PopMax()
+}
+
+func (bq *boundedPriorityQueue[T, P]) popBest() *Item[T, P] {
+	if bq.kind == MaxHeap {
+		return bq.heap.PopMax()
+	}
+	return bq.heap.PopMin()
+}
+
+// Len 返回队列中元素的数量
+func (bq *boundedPriorityQueue[T, P]) Len() int {
+	return bq.heap.Len()
+}
+
+// IsEmpty 检查队列是否为空
+func (bq *boundedPriorityQueue[T, P]) IsEmpty() bool {
+	return bq.heap.IsEmpty()
+}
+
+// outranksWorst 判断priority是否比当前最该被淘汰的元素更值得保留
+func (bq *boundedPriorityQueue[T, P]) outranksWorst(priority P) bool {
+	var worst *Item[T, P]
+	if bq.kind == MaxHeap {
+		worst = bq.heap.PeekMin()
+	} else {
+		worst = bq.heap.PeekMax()
+	}
+	if worst == nil {
+		return true
+	}
+	if bq.kind == MaxHeap {
+		return priority > worst.Priority
+	}
+	return priority < worst.Priority
+}
+
+// Put 将元素加入队列;队列已满时,只有比当前最差元素更值得保留的新元素才会被接纳,
+// 接纳时会淘汰最差元素,否则新元素被直接丢弃
+func (bq *boundedPriorityQueue[T, P]) Put(value T, priority P) {
+	if bq.capacity <= 0 {
+		return
+	}
+	if bq.heap.Len() < bq.capacity {
+		bq.heap.Put(value, priority)
+		return
+	}
+	if !bq.outranksWorst(priority) {
+		return
+	}
+	bq.popWorst()
+	bq.heap.Put(value, priority)
+}
+
+// BatchPut 按照Put的淘汰规则逐个批量加入元素
+func (bq *boundedPriorityQueue[T, P]) BatchPut(items ...*Item[T, P]) {
+	for _, item := range items {
+		bq.Put(item.Value, item.Priority)
+	}
+}
+
+// Get 返回队列中最值得保留的元素而不移除它
+func (bq *boundedPriorityQueue[T, P]) Get() *Item[T, P] {
+	return bq.best()
+}
+
+// GetAndPop 移除并返回队列中最值得保留的元素
+func (bq *boundedPriorityQueue[T, P]) GetAndPop() *Item[T, P] {
+	return bq.popBest()
+}
+
+// Update 更新队列中已有元素的优先级
+func (bq *boundedPriorityQueue[T, P]) Update(value T, priority P) {
+	bq.heap.Update(value, priority)
+}
+
+// Upsert 更新已存在值的优先级,若该值不存在则按Put的淘汰规则将其作为新元素加入
+func (bq *boundedPriorityQueue[T, P]) Upsert(value T, priority P) {
+	if bq.heap.Contains(value) {
+		bq.heap.Update(value, priority)
+		return
+	}
+	bq.Put(value, priority)
+}
+
+// Remove 从队列中移除指定值的元素
+func (bq *boundedPriorityQueue[T, P]) Remove(value T) (*Item[T, P], bool) {
+	return bq.heap.Remove(value)
+}
+
+// Contains 检查指定值的元素是否在队列中
+func (bq *boundedPriorityQueue[T, P]) Contains(value T) bool {
+	return bq.heap.Contains(value)
+}
+
+// PeekPriority 返回指定值元素当前的优先级
+func (bq *boundedPriorityQueue[T, P]) PeekPriority(value T) (P, bool) {
+	return bq.heap.PeekPriority(value)
+}
+
+// Clear 清空队列
+func (bq *boundedPriorityQueue[T, P]) Clear() {
+	bq.heap.Clear()
+}
+
+// Drain 按排序顺序(最优先的在前)清空并返回队列中的全部元素
+func (bq *boundedPriorityQueue[T, P]) Drain() []*Item[T, P] {
+	n := bq.heap.Len()
+	result := make([]*Item[T, P], n)
+	for i := 0; i < n; i++ {
+		result[i] = bq.popBest()
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+package priorityqueue
+
+import "testing"
+
+func TestBoundedGetReturnsBestNotWorst(t *testing.T) {
+	q := NewBounded[string, int](MaxHeap, 3)
+	q.Put("a", 10)
+	q.Put("b", 20)
+	q.Put("c", 5)
+
+	if got := q.Get(); got == nil || got.Value != "b" || got.Priority != 20 {
+		t.Fatalf("Get() = %v, want (b, 20)", got)
+	}
+	if got := q.GetAndPop(); got == nil || got.Value != "b" || got.Priority != 20 {
+		t.Fatalf("GetAndPop() = %v, want (b, 20)", got)
+	}
+	if got := q.Get(); got == nil || got.Value != "a" || got.Priority != 10 {
+		t.Fatalf("Get() after pop = %v, want (a, 10)", got)
+	}
+}
+
+func TestBoundedMinHeapGetReturnsBest(t *testing.T) {
+	q := NewBounded[string, int](MinHeap, 3)
+	q.Put("a", 10)
+	q.Put("b", 20)
+	q.Put("c", 5)
+
+	if got := q.Get(); got == nil || got.Value != "c" || got.Priority != 5 {
+		t.Fatalf("Get() = %v, want (c, 5)", got)
+	}
+}
+
+func TestBoundedEvictsWorstWhenFull(t *testing.T) {
+	q := NewBounded[string, int](MaxHeap, 2)
+	q.Put("a", 10)
+	q.Put("b", 20)
+	q.Put("c", 5) // worse than both, should be dropped
+	if q.Len() != 2 || q.Contains("c") {
+		t.Fatalf("expected c to be dropped, got Len()=%d Contains(c)=%v", q.Len(), q.Contains("c"))
+	}
+	q.Put("d", 30) // better than worst (a, 10), should evict a
+	if q.Contains("a") || !q.Contains("b") || !q.Contains("d") {
+		t.Fatalf("expected a evicted, b and d kept")
+	}
+}
+
+func TestBoundedDrainOrder(t *testing.T) {
+	q := NewBounded[string, int](MaxHeap, 3)
+	q.Put("a", 10)
+	q.Put("b", 20)
+	q.Put("c", 5)
+
+	drained := q.Drain()
+	want := []string{"b", "a", "c"}
+	if len(drained) != len(want) {
+		t.Fatalf("Drain() len = %d, want %d", len(drained), len(want))
+	}
+	for i, v := range want {
+		if drained[i].Value != v {
+			t.Fatalf("Drain()[%d] = %v, want %s", i, drained[i], v)
+		}
+	}
+	if !q.IsEmpty() {
+		t.Fatalf("expected queue empty after Drain")
+	}
+}
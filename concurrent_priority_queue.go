@@ -0,0 +1,122 @@
+package priorityqueue
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ErrQueueClosed 在队列已被Close后,所有等待中和后续的阻塞调用都会返回该错误
+var ErrQueueClosed = errors.New("priorityqueue: queue is closed")
+
+// ConcurrentPriorityQueue 是可安全地被多个goroutine并发访问的优先级队列,
+// GetAndPopCtx在队列为空时会阻塞等待,直到有新元素到来、ctx被取消或队列被关闭
+type ConcurrentPriorityQueue[T any, P constraints.Ordered] interface {
+	Len() int
+	IsEmpty() bool
+	PutCtx(ctx context.Context, value T, priority P) error
+	BatchPutCtx(ctx context.Context, items ...*Item[T, P]) error
+	GetAndPopCtx(ctx context.Context) (*Item[T, P], error)
+	Close() error
+}
+
+// lockedPriorityQueue 用一把互斥锁包住HeapPriorityQueue,取代原先仅靠
+// atomic.LoadPointer/StorePointer实现的伪并发安全 —— heap.Push/Pop/Fix本身
+// 会对切片和map做多次交错读写,必须让整个操作在锁内串行化才是真正安全的
+type lockedPriorityQueue[T comparable, P constraints.Ordered] struct {
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	heap     *HeapPriorityQueue[T, P]
+	closed   bool
+}
+
+// NewConcurrent 创建一个可安全并发访问的优先级队列
+func NewConcurrent[T comparable, P constraints.Ordered](kind HeapType) ConcurrentPriorityQueue[T, P] {
+	cq := &lockedPriorityQueue[T, P]{
+		heap: New[T, P](kind).(*HeapPriorityQueue[T, P]),
+	}
+	cq.notEmpty = sync.NewCond(&cq.mu)
+	return cq
+}
+
+// Len 返回队列中元素的数量
+func (cq *lockedPriorityQueue[T, P]) Len() int {
+	cq.mu.Lock()
+	defer cq.mu.Unlock()
+	return cq.heap.Len()
+}
+
+// IsEmpty 检查队列是否为空
+func (cq *lockedPriorityQueue[T, P]) IsEmpty() bool {
+	return cq.Len() == 0
+}
+
+// PutCtx 将元素加入队列并唤醒一个等待中的GetAndPopCtx调用
+func (cq *lockedPriorityQueue[T, P]) PutCtx(ctx context.Context, value T, priority P) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	cq.mu.Lock()
+	defer cq.mu.Unlock()
+	if cq.closed {
+		return ErrQueueClosed
+	}
+	cq.heap.Put(value, priority)
+	cq.notEmpty.Signal()
+	return nil
+}
+
+// BatchPutCtx 批量将元素加入队列并唤醒所有等待中的GetAndPopCtx调用
+func (cq *lockedPriorityQueue[T, P]) BatchPutCtx(ctx context.Context, items ...*Item[T, P]) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	cq.mu.Lock()
+	defer cq.mu.Unlock()
+	if cq.closed {
+		return ErrQueueClosed
+	}
+	cq.heap.BatchPut(items...)
+	cq.notEmpty.Broadcast()
+	return nil
+}
+
+// GetAndPopCtx 移除并返回队列中的下一个元素;队列为空时阻塞等待,
+// 直到有新元素到来、ctx被取消或队列被Close
+func (cq *lockedPriorityQueue[T, P]) GetAndPopCtx(ctx context.Context) (*Item[T, P], error) {
+	if ctx.Done() != nil {
+		stop := context.AfterFunc(ctx, func() {
+			cq.mu.Lock()
+			cq.notEmpty.Broadcast()
+			cq.mu.Unlock()
+		})
+		defer stop()
+	}
+
+	cq.mu.Lock()
+	defer cq.mu.Unlock()
+	for cq.heap.Len() == 0 {
+		if cq.closed {
+			return nil, ErrQueueClosed
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		cq.notEmpty.Wait()
+	}
+	return cq.heap.GetAndPop(), nil
+}
+
+// Close 关闭队列,唤醒所有等待中的GetAndPopCtx调用并让它们返回ErrQueueClosed
+func (cq *lockedPriorityQueue[T, P]) Close() error {
+	cq.mu.Lock()
+	defer cq.mu.Unlock()
+	if cq.closed {
+		return nil
+	}
+	cq.closed = true
+	cq.notEmpty.Broadcast()
+	return nil
+}
@@ -0,0 +1,103 @@
+package priorityqueue
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestConcurrentPutThenGet(t *testing.T) {
+	cq := NewConcurrent[string, int](MinHeap)
+	ctx := context.Background()
+
+	if err := cq.PutCtx(ctx, "a", 5); err != nil {
+		t.Fatalf("PutCtx: %v", err)
+	}
+	if err := cq.PutCtx(ctx, "b", 1); err != nil {
+		t.Fatalf("PutCtx: %v", err)
+	}
+
+	item, err := cq.GetAndPopCtx(ctx)
+	if err != nil {
+		t.Fatalf("GetAndPopCtx: %v", err)
+	}
+	if item.Value != "b" {
+		t.Fatalf("GetAndPopCtx = %v, want b", item)
+	}
+	if cq.Len() != 1 {
+		t.Fatalf("Len() = %d, want 1", cq.Len())
+	}
+}
+
+func TestConcurrentGetAndPopCtxBlocksUntilPut(t *testing.T) {
+	cq := NewConcurrent[string, int](MinHeap)
+	ctx := context.Background()
+
+	result := make(chan *Item[string, int], 1)
+	go func() {
+		item, err := cq.GetAndPopCtx(ctx)
+		if err != nil {
+			t.Errorf("GetAndPopCtx: %v", err)
+			return
+		}
+		result <- item
+	}()
+
+	select {
+	case <-result:
+		t.Fatalf("GetAndPopCtx returned before any Put")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if err := cq.PutCtx(ctx, "a", 1); err != nil {
+		t.Fatalf("PutCtx: %v", err)
+	}
+
+	select {
+	case item := <-result:
+		if item.Value != "a" {
+			t.Fatalf("GetAndPopCtx = %v, want a", item)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("GetAndPopCtx did not wake up after Put")
+	}
+}
+
+func TestConcurrentGetAndPopCtxCancellation(t *testing.T) {
+	cq := NewConcurrent[string, int](MinHeap)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := cq.GetAndPopCtx(ctx); err == nil {
+		t.Fatalf("expected GetAndPopCtx to return an error for a cancelled context")
+	}
+}
+
+func TestConcurrentCloseWakesWaiters(t *testing.T) {
+	cq := NewConcurrent[string, int](MinHeap)
+	ctx := context.Background()
+
+	errs := make(chan error, 1)
+	go func() {
+		_, err := cq.GetAndPopCtx(ctx)
+		errs <- err
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	if err := cq.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	select {
+	case err := <-errs:
+		if err != ErrQueueClosed {
+			t.Fatalf("GetAndPopCtx error = %v, want ErrQueueClosed", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("GetAndPopCtx did not wake up after Close")
+	}
+
+	if err := cq.PutCtx(ctx, "a", 1); err != ErrQueueClosed {
+		t.Fatalf("PutCtx after Close = %v, want ErrQueueClosed", err)
+	}
+}
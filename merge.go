@@ -0,0 +1,42 @@
+package priorityqueue
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Merge 对多个优先级队列做k路归并,返回按kind排序的新队列。
+// 做法是维护一个只包含各source当前堆顶的小顶(或大顶)堆,每次弹出全局最优的那个堆顶,
+// 并从它所属的source中补入下一个元素 —— 与合并K个有序链表时用堆维护堆头的方式相同。
+// sources会被逐个GetAndPop耗尽,因此本函数适合合并分片并行产出的top-K结果等一次性场景。
+// 各source之间的Value必须互不重复:merged内部仍是靠Value去重定位的单个HeapPriorityQueue,
+// 如果两个source出现相同的Value,后写入的Put会覆盖lookup中的映射,导致先写入的那个元素
+// 残留在堆的底层切片里却再也无法通过Remove/Update/PeekPriority访问到。
+func Merge[T comparable, P constraints.Ordered](kind HeapType, sources ...PriorityQueue[T, P]) PriorityQueue[T, P] {
+	merged := New[T, P](kind)
+	if len(sources) == 0 {
+		return merged
+	}
+
+	// heads以source在sources中的下标为value,以该source当前堆顶的优先级排序
+	heads := New[int, P](kind)
+	pending := make([]*Item[T, P], len(sources))
+	for i, src := range sources {
+		if !src.IsEmpty() {
+			pending[i] = src.GetAndPop()
+			heads.Put(i, pending[i].Priority)
+		}
+	}
+
+	for !heads.IsEmpty() {
+		winner := heads.GetAndPop()
+		idx := winner.Value
+		merged.Put(pending[idx].Value, pending[idx].Priority)
+
+		if src := sources[idx]; !src.IsEmpty() {
+			pending[idx] = src.GetAndPop()
+			heads.Put(idx, pending[idx].Priority)
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,43 @@
+package priorityqueue
+
+import "testing"
+
+func TestMergeOrdersAcrossSources(t *testing.T) {
+	a := New[string, int](MinHeap)
+	a.Put("a1", 5)
+	a.Put("a2", 1)
+
+	b := New[string, int](MinHeap)
+	b.Put("b1", 9)
+	b.Put("b2", 3)
+
+	merged := Merge[string, int](MinHeap, a, b)
+	want := []int{1, 3, 5, 9}
+	for i, w := range want {
+		item := merged.GetAndPop()
+		if item == nil || item.Priority != w {
+			t.Fatalf("merged[%d] priority = %v, want %d", i, item, w)
+		}
+	}
+	if !merged.IsEmpty() {
+		t.Fatalf("expected merged queue to be drained")
+	}
+}
+
+func TestMergeNoSources(t *testing.T) {
+	merged := Merge[string, int](MinHeap)
+	if !merged.IsEmpty() {
+		t.Fatalf("expected empty merge result for zero sources")
+	}
+}
+
+func TestMergeSkipsEmptySource(t *testing.T) {
+	a := New[string, int](MinHeap)
+	a.Put("a1", 2)
+	empty := New[string, int](MinHeap)
+
+	merged := Merge[string, int](MinHeap, a, empty)
+	if merged.Len() != 1 {
+		t.Fatalf("Len() = %d, want 1", merged.Len())
+	}
+}
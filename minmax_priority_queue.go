@@ -0,0 +1,319 @@
+package priorityqueue
+
+import (
+	"math/bits"
+
+	"golang.org/x/exp/constraints"
+)
+
+// DoubleEndedPriorityQueue 同时支持以O(1)查看、O(log n)弹出两端元素(最小和最大),
+// 适用于滑动窗口最值、"保留最好的同时淘汰最差的"等单端堆无法高效表达的场景
+type DoubleEndedPriorityQueue[T any, P constraints.Ordered] interface {
+	Len() int
+	IsEmpty() bool
+	Put(value T, priority P)
+	PeekMin() *Item[T, P]
+	PeekMax() *Item[T, P]
+	PopMin() *Item[T, P]
+	PopMax() *Item[T, P]
+	Update(value T, priority P)
+	Remove(value T) (*Item[T, P], bool)
+	Contains(value T) bool
+	PeekPriority(value T) (P, bool)
+	Clear()
+}
+
+// minMaxHeap 是基于Atkinson等人提出的min-max heap实现的双端优先级队列:
+// 数组中偶数深度(0, 2, ...)的节点满足相对于其子孙的最小堆性质,
+// 奇数深度(1, 3, ...)的节点满足最大堆性质,因此根始终是最小值,
+// 根的某个孩子始终是最大值
+type minMaxHeap[T comparable, P constraints.Ordered] struct {
+	items  []*Item[T, P]
+	lookup map[T]int
+}
+
+// NewMinMax 创建一个空的min-max双端优先级队列
+func NewMinMax[T comparable, P constraints.Ordered]() DoubleEndedPriorityQueue[T, P] {
+	return &minMaxHeap[T, P]{
+		items:  make([]*Item[T, P], 0),
+		lookup: make(map[T]int),
+	}
+}
+
+// Len 返回队列中元素的数量
+func (q *minMaxHeap[T, P]) Len() int {
+	return len(q.items)
+}
+
+// IsEmpty 检查队列是否为空
+func (q *minMaxHeap[T, P]) IsEmpty() bool {
+	return len(q.items) == 0
+}
+
+// isMinLevel 判断索引i所在的深度是否为最小堆层(深度从0开始,偶数深度为最小层)
+func isMinLevel(i int) bool {
+	return bits.Len(uint(i+1))%2 == 1
+}
+
+func (q *minMaxHeap[T, P]) swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+	q.lookup[q.items[i].Value] = i
+	q.lookup[q.items[j].Value] = j
+}
+
+// Put 将元素加入队列
+func (q *minMaxHeap[T, P]) Put(value T, priority P) {
+	item := &Item[T, P]{Value: value, Priority: priority}
+	q.items = append(q.items, item)
+	i := len(q.items) - 1
+	q.lookup[value] = i
+	q.pushUp(i)
+}
+
+// pushUp 将索引i处新插入(或被修改)的元素上浮到合适位置。
+// 每次交换都会把祖先的旧值换到较低的位置i上,而i的孩子(由数组下标固定,
+// 与之前在parent位置时的孩子并不是同一组)此前从未针对这个旧值校验过,
+// 所以每次交换后都必须对i重新pushDown一次,否则旧值可能不再支配它在新位置上的子孙
+func (q *minMaxHeap[T, P]) pushUp(i int) {
+	if i == 0 {
+		return
+	}
+	parent := (i - 1) / 2
+	if isMinLevel(i) {
+		if q.items[i].Priority > q.items[parent].Priority {
+			q.swap(i, parent)
+			q.pushDown(i)
+			q.pushUpMax(parent)
+		} else {
+			q.pushUpMin(i)
+		}
+		return
+	}
+	if q.items[i].Priority < q.items[parent].Priority {
+		q.swap(i, parent)
+		q.pushDown(i)
+		q.pushUpMin(parent)
+	} else {
+		q.pushUpMax(i)
+	}
+}
+
+func (q *minMaxHeap[T, P]) pushUpMin(i int) {
+	if i < 3 {
+		return
+	}
+	grandparent := (i - 3) / 4
+	if q.items[i].Priority < q.items[grandparent].Priority {
+		q.swap(i, grandparent)
+		q.pushDown(i)
+		q.pushUpMin(grandparent)
+	}
+}
+
+func (q *minMaxHeap[T, P]) pushUpMax(i int) {
+	if i < 3 {
+		return
+	}
+	grandparent := (i - 3) / 4
+	if q.items[i].Priority > q.items[grandparent].Priority {
+		q.swap(i, grandparent)
+		q.pushDown(i)
+		q.pushUpMax(grandparent)
+	}
+}
+
+// pushDown 将索引i处的元素下沉到合适位置,返回过程中是否发生过交换
+func (q *minMaxHeap[T, P]) pushDown(i int) bool {
+	if isMinLevel(i) {
+		return q.pushDownMin(i)
+	}
+	return q.pushDownMax(i)
+}
+
+// isGrandchild 判断m是否为i的孙子(而非孩子)
+func isGrandchild(i, m int) bool {
+	return (m-1)/2 != i
+}
+
+func (q *minMaxHeap[T, P]) pushDownMin(i int) bool {
+	moved := false
+	for {
+		m := q.extremeDescendant(i, func(a, b P) bool { return a < b })
+		if m == -1 || q.items[m].Priority >= q.items[i].Priority {
+			return moved
+		}
+		q.swap(i, m)
+		moved = true
+		if !isGrandchild(i, m) {
+			return moved
+		}
+		parent := (m - 1) / 2
+		if q.items[m].Priority > q.items[parent].Priority {
+			q.swap(m, parent)
+		}
+		i = m
+	}
+}
+
+func (q *minMaxHeap[T, P]) pushDownMax(i int) bool {
+	moved := false
+	for {
+		m := q.extremeDescendant(i, func(a, b P) bool { return a > b })
+		if m == -1 || q.items[m].Priority <= q.items[i].Priority {
+			return moved
+		}
+		q.swap(i, m)
+		moved = true
+		if !isGrandchild(i, m) {
+			return moved
+		}
+		parent := (m - 1) / 2
+		if q.items[m].Priority < q.items[parent].Priority {
+			q.swap(m, parent)
+		}
+		i = m
+	}
+}
+
+// extremeDescendant 在i的孩子和孙子中,按better给出的排序返回最优者的索引,不存在则返回-1
+func (q *minMaxHeap[T, P]) extremeDescendant(i int, better func(a, b P) bool) int {
+	n := len(q.items)
+	best := -1
+	consider := func(idx int) {
+		if idx < n && (best == -1 || better(q.items[idx].Priority, q.items[best].Priority)) {
+			best = idx
+		}
+	}
+	left, right := 2*i+1, 2*i+2
+	consider(left)
+	consider(right)
+	if left < n {
+		consider(2*left + 1)
+		consider(2*left + 2)
+	}
+	if right < n {
+		consider(2*right + 1)
+		consider(2*right + 2)
+	}
+	return best
+}
+
+// PeekMin 返回队列中优先级最小的元素而不移除它
+func (q *minMaxHeap[T, P]) PeekMin() *Item[T, P] {
+	if len(q.items) == 0 {
+		return nil
+	}
+	return q.items[0]
+}
+
+// PeekMax 返回队列中优先级最大的元素而不移除它
+func (q *minMaxHeap[T, P]) PeekMax() *Item[T, P] {
+	switch len(q.items) {
+	case 0:
+		return nil
+	case 1:
+		return q.items[0]
+	case 2:
+		return q.items[1]
+	default:
+		if q.items[2].Priority > q.items[1].Priority {
+			return q.items[2]
+		}
+		return q.items[1]
+	}
+}
+
+// PopMin 移除并返回队列中优先级最小的元素
+func (q *minMaxHeap[T, P]) PopMin() *Item[T, P] {
+	if len(q.items) == 0 {
+		return nil
+	}
+	return q.removeAt(0)
+}
+
+// PopMax 移除并返回队列中优先级最大的元素
+func (q *minMaxHeap[T, P]) PopMax() *Item[T, P] {
+	switch len(q.items) {
+	case 0:
+		return nil
+	case 1:
+		return q.removeAt(0)
+	case 2:
+		return q.removeAt(1)
+	default:
+		if q.items[2].Priority > q.items[1].Priority {
+			return q.removeAt(2)
+		}
+		return q.removeAt(1)
+	}
+}
+
+// removeAt 移除索引i处的元素并恢复堆的有效性
+func (q *minMaxHeap[T, P]) removeAt(i int) *Item[T, P] {
+	item := q.items[i]
+	delete(q.lookup, item.Value)
+
+	last := len(q.items) - 1
+	if i != last {
+		q.items[i] = q.items[last]
+		q.lookup[q.items[i].Value] = i
+	}
+	q.items = q.items[:last]
+
+	if i < len(q.items) {
+		q.fix(i)
+	}
+	return item
+}
+
+// fix 在索引i处的值被任意修改(或被末尾元素替换)后恢复堆的有效性。
+// 与单端heap.Fix的"下沉若无变化才上浮"不同,min-max heap的节点同时受两种
+// 独立约束:是否仍支配其全部子孙(下沉方向),以及是否仍被同层祖先支配
+// (上浮方向)——这两者不是互斥的,任意修改后都必须各自检查一次:
+// 先上浮以满足与祖先的关系,再从上浮后的新位置下沉以满足与子孙的关系
+func (q *minMaxHeap[T, P]) fix(i int) {
+	value := q.items[i].Value
+	q.pushUp(i)
+	q.pushDown(q.lookup[value])
+}
+
+// Update 更新元素的优先级
+func (q *minMaxHeap[T, P]) Update(value T, priority P) {
+	i, ok := q.lookup[value]
+	if !ok || q.items[i].Priority == priority {
+		return
+	}
+	q.items[i].Priority = priority
+	q.fix(i)
+}
+
+// Remove 从队列中移除指定值的元素,若该值不存在则返回(nil, false)
+func (q *minMaxHeap[T, P]) Remove(value T) (*Item[T, P], bool) {
+	i, ok := q.lookup[value]
+	if !ok {
+		return nil, false
+	}
+	return q.removeAt(i), true
+}
+
+// Contains 检查指定值的元素是否在队列中
+func (q *minMaxHeap[T, P]) Contains(value T) bool {
+	_, ok := q.lookup[value]
+	return ok
+}
+
+// PeekPriority 返回指定值元素当前的优先级,若该值不存在则返回(零值, false)
+func (q *minMaxHeap[T, P]) PeekPriority(value T) (P, bool) {
+	i, ok := q.lookup[value]
+	if !ok {
+		var zero P
+		return zero, false
+	}
+	return q.items[i].Priority, true
+}
+
+// Clear 清空队列
+func (q *minMaxHeap[T, P]) Clear() {
+	q.items = make([]*Item[T, P], 0)
+	q.lookup = make(map[T]int)
+}
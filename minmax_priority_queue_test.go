@@ -0,0 +1,144 @@
+package priorityqueue
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// refExtremes scans the live set directly, independent of the heap under test
+func refExtremes(live map[int]int) (minV, minP int, hasMin bool, maxV, maxP int, hasMax bool) {
+	for v, p := range live {
+		if !hasMin || p < minP {
+			minV, minP, hasMin = v, p, true
+		}
+		if !hasMax || p > maxP {
+			maxV, maxP, hasMax = v, p, true
+		}
+	}
+	return
+}
+
+// minMaxFuzz drives a randomized sequence of Put/PopMin/PopMax/Update/Remove against
+// NewMinMax and cross-checks every observable result against a plain map kept in lockstep
+func minMaxFuzz(t *testing.T, seed int64, ops int) {
+	t.Helper()
+	r := rand.New(rand.NewSource(seed))
+	q := NewMinMax[int, int]()
+	live := make(map[int]int)
+	next := 0
+
+	check := func() {
+		_, minP, hasMin, _, maxP, hasMax := refExtremes(live)
+		if got := q.PeekMin(); hasMin {
+			if got == nil || got.Priority != minP {
+				t.Fatalf("seed=%d: PeekMin = %v, want priority %d", seed, got, minP)
+			}
+		} else if got != nil {
+			t.Fatalf("seed=%d: PeekMin = %v, want nil", seed, got)
+		}
+		if got := q.PeekMax(); hasMax {
+			if got == nil || got.Priority != maxP {
+				t.Fatalf("seed=%d: PeekMax = %v, want priority %d", seed, got, maxP)
+			}
+		} else if got != nil {
+			t.Fatalf("seed=%d: PeekMax = %v, want nil", seed, got)
+		}
+		if q.Len() != len(live) {
+			t.Fatalf("seed=%d: Len() = %d, want %d", seed, q.Len(), len(live))
+		}
+	}
+
+	for i := 0; i < ops; i++ {
+		switch {
+		case len(live) == 0 || r.Intn(4) == 0:
+			v, p := next, r.Intn(1000)
+			next++
+			q.Put(v, p)
+			live[v] = p
+		case r.Intn(3) == 0:
+			_, minP, _, _, _, _ := refExtremes(live)
+			got := q.PopMin()
+			if got == nil || got.Priority != minP {
+				t.Fatalf("seed=%d op=%d: PopMin = %v, want priority %d", seed, i, got, minP)
+			}
+			delete(live, got.Value)
+		case r.Intn(2) == 0:
+			_, _, _, _, maxP, _ := refExtremes(live)
+			got := q.PopMax()
+			if got == nil || got.Priority != maxP {
+				t.Fatalf("seed=%d op=%d: PopMax = %v, want priority %d", seed, i, got, maxP)
+			}
+			delete(live, got.Value)
+		default:
+			for v := range live {
+				if r.Intn(2) == 0 {
+					p := r.Intn(1000)
+					q.Update(v, p)
+					live[v] = p
+				} else {
+					item, ok := q.Remove(v)
+					if !ok || item.Value != v {
+						t.Fatalf("seed=%d op=%d: Remove(%d) = (%v, %v)", seed, i, v, item, ok)
+					}
+					delete(live, v)
+				}
+				break
+			}
+		}
+		check()
+	}
+}
+
+// TestMinMaxUpdateCorruption reproduces a regression where repeated Update calls left
+// PopMax/PeekMax returning a stale item instead of the true highest-priority one
+func TestMinMaxUpdateCorruption(t *testing.T) {
+	minMaxFuzz(t, 0, 180)
+}
+
+// TestMinMaxRemoveCorruption reproduces a regression where Remove left PeekMax/PopMax
+// reporting the wrong item; this seed's sequence is Remove-heavy but also calls Update
+func TestMinMaxRemoveCorruption(t *testing.T) {
+	minMaxFuzz(t, 116, 180)
+}
+
+// TestMinMaxFuzzManySeeds sweeps many seeds/lengths for broader coverage beyond the two
+// seeds that originally reproduced the regression
+func TestMinMaxFuzzManySeeds(t *testing.T) {
+	for seed := int64(0); seed < 200; seed++ {
+		minMaxFuzz(t, seed, 300)
+	}
+}
+
+func TestMinMaxBasics(t *testing.T) {
+	q := NewMinMax[string, int]()
+	if q.PeekMin() != nil || q.PeekMax() != nil || !q.IsEmpty() {
+		t.Fatalf("expected empty queue")
+	}
+	q.Put("a", 5)
+	q.Put("b", 1)
+	q.Put("c", 9)
+	if q.Len() != 3 {
+		t.Fatalf("Len() = %d, want 3", q.Len())
+	}
+	if !q.Contains("b") || q.Contains("z") {
+		t.Fatalf("Contains() wrong")
+	}
+	if p, ok := q.PeekPriority("c"); !ok || p != 9 {
+		t.Fatalf("PeekPriority(c) = (%d, %v), want (9, true)", p, ok)
+	}
+	if min := q.PeekMin(); min.Value != "b" {
+		t.Fatalf("PeekMin() = %v, want b", min)
+	}
+	if max := q.PeekMax(); max.Value != "c" {
+		t.Fatalf("PeekMax() = %v, want c", max)
+	}
+	if min := q.PopMin(); min.Value != "b" {
+		t.Fatalf("PopMin() = %v, want b", min)
+	}
+	if max := q.PopMax(); max.Value != "c" {
+		t.Fatalf("PopMax() = %v, want c", max)
+	}
+	if q.Len() != 1 {
+		t.Fatalf("Len() = %d, want 1", q.Len())
+	}
+}
@@ -15,6 +15,11 @@ type PriorityQueue[T any, P constraints.Ordered] interface {
 	GetAndPop() *Item[T, P]
 	IsEmpty() bool
 	Update(value T, priority P)
+	// Upsert 更新已存在值的优先级,若该值不存在则将其作为新元素加入
+	Upsert(value T, priority P)
+	Remove(value T) (*Item[T, P], bool)
+	Contains(value T) bool
+	PeekPriority(value T) (P, bool)
 	Clear()
 }
 
@@ -152,6 +157,50 @@ func (pq *HeapPriorityQueue[T, P]) Update(value T, priority P) {
 	}
 }
 
+// Upsert 更新已存在值的优先级,若该值不存在则将其作为新元素加入
+func (pq *HeapPriorityQueue[T, P]) Upsert(value T, priority P) {
+	lookup := (*map[T]int)(atomic.LoadPointer(&pq.lookup))
+	if index, ok := (*lookup)[value]; ok {
+		items := (*[]*Item[T, P])(atomic.LoadPointer(&pq.items))
+		if (*items)[index].Priority != priority {
+			(*items)[index].Priority = priority
+			heap.Fix(pq, index)
+		}
+		return
+	}
+	pq.Put(value, priority)
+}
+
+// Remove 从优先级队列中移除指定值的元素,若该值不存在则返回(nil, false)
+func (pq *HeapPriorityQueue[T, P]) Remove(value T) (*Item[T, P], bool) {
+	lookup := (*map[T]int)(atomic.LoadPointer(&pq.lookup))
+	index, ok := (*lookup)[value]
+	if !ok {
+		return nil, false
+	}
+	item := heap.Remove(pq, index).(*Item[T, P])
+	return item, true
+}
+
+// Contains 检查指定值的元素是否在优先级队列中
+func (pq *HeapPriorityQueue[T, P]) Contains(value T) bool {
+	lookup := (*map[T]int)(atomic.LoadPointer(&pq.lookup))
+	_, ok := (*lookup)[value]
+	return ok
+}
+
+// PeekPriority 返回指定值元素当前的优先级,若该值不存在则返回(零值, false)
+func (pq *HeapPriorityQueue[T, P]) PeekPriority(value T) (P, bool) {
+	lookup := (*map[T]int)(atomic.LoadPointer(&pq.lookup))
+	index, ok := (*lookup)[value]
+	if !ok {
+		var zero P
+		return zero, false
+	}
+	items := (*[]*Item[T, P])(atomic.LoadPointer(&pq.items))
+	return (*items)[index].Priority, true
+}
+
 // Clear 清空优先级队列
 func (pq *HeapPriorityQueue[T, P]) Clear() {
 	items := make([]*Item[T, P], 0)
@@ -182,8 +231,45 @@ func (pq *HeapPriorityQueue[T, P]) BatchPut(items ...*Item[T, P]) {
 	}
 	atomic.StorePointer(&pq.lookup, unsafe.Pointer(&newLookup))
 
-	// 调整堆
-	for i := len(*oldItems); i < len(newItems); i++ {
-		heap.Fix(pq, i)
+	// 使用Floyd自底向上建堆,一次性将整个切片调整为合法堆,O(n)
+	for i := len(newItems)/2 - 1; i >= 0; i-- {
+		pq.siftDown(i, len(newItems))
+	}
+}
+
+// siftDown 将索引i处的元素下沉到合适位置,n为堆的有效长度
+func (pq *HeapPriorityQueue[T, P]) siftDown(i, n int) {
+	for {
+		left := 2*i + 1
+		if left >= n {
+			return
+		}
+		smallest := left
+		if right := left + 1; right < n && pq.Less(right, left) {
+			smallest = right
+		}
+		if !pq.Less(smallest, i) {
+			return
+		}
+		pq.Swap(i, smallest)
+		i = smallest
+	}
+}
+
+// NewFromSlice 基于已有的元素切片一次性建堆,适用于加载持久化状态或从其他来源批量导入,
+// 相比逐个Put+heap.Fix可将重建成本从O(n log n)降到O(n)
+func NewFromSlice[T comparable, P constraints.Ordered](kind HeapType, items []*Item[T, P]) PriorityQueue[T, P] {
+	slice := make([]*Item[T, P], len(items))
+	copy(slice, items)
+	lookup := make(map[T]int, len(slice))
+	for i, item := range slice {
+		lookup[item.Value] = i
 	}
+	pq := &HeapPriorityQueue[T, P]{
+		items:    unsafe.Pointer(&slice),
+		lookup:   unsafe.Pointer(&lookup),
+		heapType: kind,
+	}
+	heap.Init(pq)
+	return pq
 }
@@ -0,0 +1,57 @@
+package priorityqueue
+
+import "testing"
+
+func TestContainsAndPeekPriority(t *testing.T) {
+	pq := New[string, int](MinHeap)
+	if pq.Contains("a") {
+		t.Fatalf("expected empty queue to not contain a")
+	}
+	pq.Put("a", 5)
+	pq.Put("b", 1)
+
+	if !pq.Contains("a") || !pq.Contains("b") {
+		t.Fatalf("expected queue to contain a and b")
+	}
+	if p, ok := pq.PeekPriority("a"); !ok || p != 5 {
+		t.Fatalf("PeekPriority(a) = (%d, %v), want (5, true)", p, ok)
+	}
+	if _, ok := pq.PeekPriority("z"); ok {
+		t.Fatalf("PeekPriority(z) should report not found")
+	}
+}
+
+func TestRemoveRestoresHeapInvariant(t *testing.T) {
+	pq := New[int, int](MinHeap)
+	for v := 0; v < 20; v++ {
+		pq.Put(v, (v*37)%20)
+	}
+
+	item, ok := pq.Remove(7)
+	if !ok || item.Value != 7 {
+		t.Fatalf("Remove(7) = (%v, %v)", item, ok)
+	}
+	if pq.Contains(7) {
+		t.Fatalf("expected 7 to be gone after Remove")
+	}
+	if pq.Len() != 19 {
+		t.Fatalf("Len() = %d, want 19", pq.Len())
+	}
+
+	last := -1
+	for !pq.IsEmpty() {
+		got := pq.GetAndPop().Priority
+		if got < last {
+			t.Fatalf("heap invariant broken after Remove: got priority %d after %d", got, last)
+		}
+		last = got
+	}
+}
+
+func TestRemoveMissingValue(t *testing.T) {
+	pq := New[string, int](MinHeap)
+	pq.Put("a", 1)
+	if _, ok := pq.Remove("missing"); ok {
+		t.Fatalf("Remove of missing value should report false")
+	}
+}
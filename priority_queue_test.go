@@ -0,0 +1,78 @@
+package priorityqueue
+
+import "testing"
+
+func drainPriorities(pq PriorityQueue[int, int]) []int {
+	var priorities []int
+	for !pq.IsEmpty() {
+		priorities = append(priorities, pq.GetAndPop().Priority)
+	}
+	return priorities
+}
+
+func TestBatchPutOrdersLikeSequentialPut(t *testing.T) {
+	items := []*Item[int, int]{
+		{Value: 1, Priority: 5},
+		{Value: 2, Priority: 1},
+		{Value: 3, Priority: 9},
+		{Value: 4, Priority: 3},
+	}
+
+	batched := New[int, int](MinHeap)
+	batched.BatchPut(items...)
+
+	sequential := New[int, int](MinHeap)
+	for _, item := range items {
+		sequential.Put(item.Value, item.Priority)
+	}
+
+	got, want := drainPriorities(batched), drainPriorities(sequential)
+	if len(got) != len(want) {
+		t.Fatalf("BatchPut drain len = %d, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("BatchPut drain[%d] = %d, want %d", i, got[i], want[i])
+		}
+	}
+}
+
+func TestNewFromSliceOrdersHeap(t *testing.T) {
+	items := []*Item[int, int]{
+		{Value: 1, Priority: 5},
+		{Value: 2, Priority: 1},
+		{Value: 3, Priority: 9},
+	}
+	pq := NewFromSlice[int, int](MinHeap, items)
+	if got := drainPriorities(pq); got[0] != 1 || got[1] != 5 || got[2] != 9 {
+		t.Fatalf("NewFromSlice drain = %v, want ascending [1 5 9]", got)
+	}
+}
+
+func TestUpsertInsertsWhenAbsent(t *testing.T) {
+	pq := New[string, int](MinHeap)
+	pq.Upsert("a", 5)
+	if !pq.Contains("a") {
+		t.Fatalf("expected Upsert to insert absent value")
+	}
+	if p, _ := pq.PeekPriority("a"); p != 5 {
+		t.Fatalf("PeekPriority(a) = %d, want 5", p)
+	}
+}
+
+func TestUpsertUpdatesWhenPresent(t *testing.T) {
+	pq := New[string, int](MinHeap)
+	pq.Put("a", 5)
+	pq.Put("b", 10)
+	pq.Upsert("a", 20)
+
+	if pq.Len() != 2 {
+		t.Fatalf("Upsert on existing value should not grow the queue, Len() = %d", pq.Len())
+	}
+	if p, _ := pq.PeekPriority("a"); p != 20 {
+		t.Fatalf("PeekPriority(a) = %d, want 20", p)
+	}
+	if got := pq.Get(); got.Value != "b" {
+		t.Fatalf("Get() = %v, want b to now be the minimum", got)
+	}
+}